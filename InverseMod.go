@@ -1,6 +1,7 @@
 package main
 
 import (
+	"encoding/json"
 	"fmt"
 	"log"
 	"net/http"
@@ -15,6 +16,13 @@ func main() {
 	router.HandleFunc("/inverse-mod", InverseModHandlerSteps).Methods("GET")
 	router.HandleFunc("/inverse-mod-z", InverseModHandlerZ).Methods("GET")
 	router.HandleFunc("/inverse-mod-explanation", InverseModHandlerExplanation).Methods("GET")
+	router.HandleFunc("/inverse-mod-big", InverseModBigHandlerZ).Methods("GET")
+	router.HandleFunc("/inverse-mod-big-steps", InverseModBigHandlerSteps).Methods("GET")
+	router.HandleFunc("/inverse-mod-binary", BinaryInverseModHandler).Methods("GET")
+	router.HandleFunc("/crt", CRTHandlerZ).Methods("GET")
+	router.HandleFunc("/crt-steps", CRTHandlerSteps).Methods("GET")
+	router.HandleFunc("/mod-pow", ModPowHandler).Methods("GET")
+	router.HandleFunc("/inverse-mod-fermat", InverseModFermatHandler).Methods("GET")
 	log.Fatal(http.ListenAndServe(":8000", router))
 }
 
@@ -36,6 +44,12 @@ func InverseModHandlerZ(resp http.ResponseWriter, req *http.Request) {
 InverseModHandlerExplanation - Gives an explanation of how the Inverse Mod works.
 */
 func InverseModHandlerExplanation(resp http.ResponseWriter, req *http.Request) {
+	if wantsJSON(req) {
+		resp.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(resp).Encode(explanationResponse{Explanation: inverseModExplanation()})
+		return
+	}
+	resp.Header().Set("Content-Type", "text/plain; charset=utf-8")
 	fmt.Fprintln(resp, inverseModExplanation())
 }
 
@@ -48,8 +62,7 @@ func InverseModHandler(resp http.ResponseWriter, req *http.Request, isSteps bool
 
 	// Checking inputs
 	if len(inputsX) <= 0 || len(inputsY) <= 0 {
-		fmt.Fprintln(resp, "To use this, please make the URL match something like:\nhost:port/inverse-mod?x=<<insert positive integer>>&y=<<insert positive integer>>\n\n\n"+inverseModExplanation())
-		resp.WriteHeader(400)
+		writeError(resp, req, http.StatusBadRequest, "To use this, please make the URL match something like:\nhost:port/inverse-mod?x=<<insert positive integer>>&y=<<insert positive integer>>\n\n\n"+inverseModExplanation())
 		return
 	}
 
@@ -59,19 +72,15 @@ func InverseModHandler(resp http.ResponseWriter, req *http.Request, isSteps bool
 	isXNumber, _ := regexp.MatchString("^[1-9]\\d*$", xString)
 	isYNumber, _ := regexp.MatchString("^[1-9]\\d*$", yString)
 	if !isXNumber || !isYNumber {
-		fmt.Fprintln(resp, "Error:\n x and/or y is not a positive integer, please make the URL match something like:\nhost:port/inverse-mod?x=<<insert positive integer>>&y=<<insert positive integer>>")
-		resp.WriteHeader(400)
+		writeError(resp, req, http.StatusBadRequest, "Error:\n x and/or y is not a positive integer, please make the URL match something like:\nhost:port/inverse-mod?x=<<insert positive integer>>&y=<<insert positive integer>>")
 		return
 	}
 
 	x, _ := strconv.Atoi(xString)
 	y, _ := strconv.Atoi(yString)
 
-	if isSteps {
-		fmt.Fprintln(resp, InverseModSteps(int64(x), int64(y)))
-	} else {
-		fmt.Fprintln(resp, InverseMod(int64(x), int64(y)))
-	}
+	text, res := inverseModCompute(int64(x), int64(y))
+	writeInverseModResult(resp, req, text, res, isSteps)
 }
 
 /*
@@ -82,19 +91,17 @@ func inverseModExplanation() string {
 Here is the algorithm written by Cody Weber
 
 x mod y, where x and y are members of the positive non-zero integers.
-z is a member of the positive non-zero integers
-k values are a group of values that are members of the non-negative integers.
-r values are a group of values that are members of the non-negative integers.  The goal is to get the last r value to equal 1, thus finding the inverse.
-*Note:  This does not always work.  There is some tweaking needed to get this algorithm to hit the goal much more often, but I will tweak in later versions.
+z is a member of the positive non-zero integers such that (z * x) mod y == 1.
 
-So steps:
-So for x mod y,
-1.  y < (x * k[1]) < (x + y), ((x * k[1]) % y) = r[1]
-2.  y < (r[1] * k[2]) < (r[1] + y), ((r[1] * k[2]) % y) = r[2], r[2] < r[1]
-...
-n.  y < (r[n-1] * k[n]) < (r[n-1] + y), ((r[n-1] * k[n]) % y) = r[n] = 1 (or 0 if it did not work)
+This is computed with the Extended Euclidean Algorithm.  Starting from
+(old_r, r) = (x, y) and (old_s, s) = (1, 0), each step computes:
+  q = old_r / r
+  (old_r, r) = (r, old_r - q*r)
+  (old_s, s) = (s, old_s - q*s)
+until r reaches 0.  old_r is then gcd(x, y) and old_s is a Bezout
+coefficient satisfying old_s*x + t*y = old_r for some t.
 
-(k[1] * k[2] * ... * k[n]) mod y = z
+If gcd(x, y) != 1, no inverse exists.  Otherwise z = ((old_s % y) + y) % y.
 
 Validation step:
 (z * x) mod y == 1
@@ -102,70 +109,68 @@ Validation step:
 }
 
 /*
-Performs inverseMod algorithm using x mod y.  If explanation desired, see the Inverse Mod Explanation.
+inverseModCompute runs the Extended Euclidean Algorithm for x mod y,
+returning both the pedagogical text trace and a structured inverseModResult
+for JSON rendering.
 */
-func inverseModFull(x int64, y int64) (string, int64) {
-	var k []int64
-	var r []int64
-	var z int64
-
+func inverseModCompute(x int64, y int64) (string, inverseModResult) {
 	result := fmt.Sprintln("\n\nCalculating the inverse of", x, "mod", y, "...")
 
 	// Checking for special cases
 	isSpecialCase, message := checkSpecialCases(x, y)
 	if isSpecialCase {
 		result += message
-		return result, 0
+		return result, inverseModResult{X: x, Y: y, Exists: false}
 	}
 
-	// Performing do-while
-	if (x % y) == 0 {
-		k = append(k, (y / x))
-	} else {
-
-		k = append(k, ((y / x) + 1))
+	oldR, r := x, y
+	oldS, s := int64(1), int64(0)
+
+	var steps []stepRecord
+	n := 0
+	for r != 0 {
+		n++
+		q := oldR / r
+		oldR, r = r, oldR-q*r
+		oldS, s = s, oldS-q*s
+		steps = append(steps, stepRecord{N: n, Q: q, R: r, S: oldS})
+		result += fmt.Sprintln("Step", n, ": q =", q, ", r =", r, ", s =", oldS)
 	}
-	r = append(r, ((x * k[0]) % y))
-	result += fmt.Sprintln("Step 1 :", y, " < (", x, "*", k[0], ") < (", y, "+", x, "), ((", x, "*", k[0], ") %", y, ") =", r[0])
-
-	for n := 1; r[n-1] > 1; n++ {
 
-		// Calculating if multiple comes out to 0 or a non-zero remainder
-		if (y % r[n-1]) == 0 {
-			k = append(k, (y / r[n-1]))
-		} else {
-			k = append(k, ((y / r[n-1]) + 1))
-		}
-
-		r = append(r, ((r[n-1] * k[n]) % y))
-		result += fmt.Sprintln("Step", n+1, ":", y, "< (", r[n-1], "*", k[n], ") < (", y, "+", r[n-1], "), ((", r[n-1], "*", k[n], ") %", y, ") =", r[n])
+	gcd := oldR
+	if gcd != 1 {
+		result += fmt.Sprintln("gcd(", x, ",", y, ") =", gcd, ", no inverse exists")
+		return result, inverseModResult{X: x, Y: y, Gcd: gcd, Exists: false, Steps: steps}
 	}
 
-	z = 1
-	for _, valueK := range k {
-		z *= valueK
-	}
-	z = z % y
-	result += fmt.Sprintln("(k[1] * k[2] * ... * k[n]) mod y =", z)
+	z := ((oldS % y) + y) % y
+	product := (z * x) % y
 
 	result += fmt.Sprintln("\n\nFinal Values:")
 	result += fmt.Sprintln("x =", x)
 	result += fmt.Sprintln("y =", y)
-	result += fmt.Sprintln("k[] =", k)
-	result += fmt.Sprintln("r[] =", r)
+	result += fmt.Sprintln("gcd =", gcd)
 	result += fmt.Sprintln("z =", z)
 
 	result += fmt.Sprintln("\n\nValidation step:")
-	result += fmt.Sprintln("((", z, "*", x, ") mod", y, ") == 1 is", (((z * x) % y) == 1))
-
-	return result, z
+	result += fmt.Sprintln("((", z, "*", x, ") mod", y, ") == 1 is", product == 1)
+
+	return result, inverseModResult{
+		X:          x,
+		Y:          y,
+		Z:          z,
+		Gcd:        gcd,
+		Exists:     true,
+		Steps:      steps,
+		Validation: validationRecord{Product: product, Holds: product == 1},
+	}
 }
 
 /*
 InverseModSteps - Shows the steps of the inverse.
 */
 func InverseModSteps(x int64, y int64) string {
-	steps, _ := inverseModFull(x, y)
+	steps, _ := inverseModCompute(x, y)
 	return steps
 }
 
@@ -173,8 +178,8 @@ func InverseModSteps(x int64, y int64) string {
 InverseMod - This just finds the answer of the inverse.
 */
 func InverseMod(x int64, y int64) int64 {
-	_, z := inverseModFull(x, y)
-	return z
+	_, res := inverseModCompute(x, y)
+	return res.Z
 }
 
 func checkSpecialCases(x int64, y int64) (bool, string) {
@@ -190,15 +195,5 @@ func checkSpecialCases(x int64, y int64) (bool, string) {
 		isSpecialCase = true
 		message += "Error:  y cannot be zero."
 	}
-	if isSpecialCase {
-		return isSpecialCase, message
-	}
-
-	// Case 2:  x cannot be a multiple of y and vice versa
-	if (x % y) == 0 {
-		isSpecialCase = true
-		message += fmt.Sprintln(x, "is a multiple of", y, "which gives z = 0, no inverse")
-	}
-
 	return isSpecialCase, message
 }
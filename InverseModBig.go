@@ -0,0 +1,163 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strings"
+)
+
+/*
+errorResponse is the structured JSON body returned when a big.Int endpoint
+cannot satisfy the request.
+*/
+type errorResponse struct {
+	Error string `json:"error"`
+}
+
+/*
+writeJSONError writes a structured JSON error with the given status code.
+*/
+func writeJSONError(resp http.ResponseWriter, status int, message string) {
+	resp.Header().Set("Content-Type", "application/json")
+	resp.WriteHeader(status)
+	json.NewEncoder(resp).Encode(errorResponse{Error: message})
+}
+
+/*
+InverseModBig - Finds the modular inverse of x mod y using the Extended
+Euclidean Algorithm over math/big, for inputs that may exceed int64.
+Returns an error if gcd(x, y) != 1.
+*/
+func InverseModBig(x *big.Int, y *big.Int) (*big.Int, error) {
+	_, z, err := inverseModBigFull(x, y)
+	return z, err
+}
+
+/*
+InverseModBigSteps - Shows the steps of the big.Int inverse, alongside the
+final inverse value.  Returns an error if gcd(x, y) != 1.
+*/
+func InverseModBigSteps(x *big.Int, y *big.Int) (string, *big.Int, error) {
+	return inverseModBigFull(x, y)
+}
+
+/*
+inverseModBigFull runs the Extended Euclidean Algorithm over big.Int,
+mirroring inverseModCompute's int64 recurrence, and renders the same kind of
+step-by-step trace.
+*/
+func inverseModBigFull(x *big.Int, y *big.Int) (string, *big.Int, error) {
+	result := fmt.Sprintln("\n\nCalculating the inverse of", x, "mod", y, "...")
+
+	if x.Sign() == 0 {
+		return result, nil, fmt.Errorf("x cannot be zero")
+	}
+	if y.Sign() == 0 {
+		return result, nil, fmt.Errorf("y cannot be zero")
+	}
+
+	oldR, r := new(big.Int).Set(x), new(big.Int).Set(y)
+	oldS, s := big.NewInt(1), big.NewInt(0)
+
+	n := 0
+	for r.Sign() != 0 {
+		n++
+		q := new(big.Int).Div(oldR, r)
+		nextR := new(big.Int).Sub(oldR, new(big.Int).Mul(q, r))
+		nextS := new(big.Int).Sub(oldS, new(big.Int).Mul(q, s))
+		oldR, r = r, nextR
+		oldS, s = s, nextS
+		result += fmt.Sprintln("Step", n, ": q =", q, ", r =", r, ", s =", oldS)
+	}
+
+	if oldR.Cmp(big.NewInt(1)) != 0 {
+		result += fmt.Sprintln("gcd(", x, ",", y, ") =", oldR, ", no inverse exists")
+		return result, nil, fmt.Errorf("gcd(%s, %s) = %s, no inverse exists", x, y, oldR)
+	}
+
+	z := new(big.Int).Mod(oldS, y)
+	if z.Sign() < 0 {
+		z.Add(z, y)
+	}
+
+	result += fmt.Sprintln("\n\nFinal Values:")
+	result += fmt.Sprintln("x =", x)
+	result += fmt.Sprintln("y =", y)
+	result += fmt.Sprintln("gcd =", oldR)
+	result += fmt.Sprintln("z =", z)
+
+	return result, z, nil
+}
+
+/*
+parseBigInt parses s as a decimal integer, unless it has a "0x"/"0X" prefix,
+in which case it is parsed as hexadecimal.
+*/
+func parseBigInt(s string) (*big.Int, bool) {
+	base := 10
+	if strings.HasPrefix(s, "0x") || strings.HasPrefix(s, "0X") {
+		s = s[2:]
+		base = 16
+	}
+	return new(big.Int).SetString(s, base)
+}
+
+/*
+InverseModBigHandlerSteps - Gives steps for how to solve the Inverse Mod for
+arbitrarily large x and y.
+*/
+func InverseModBigHandlerSteps(resp http.ResponseWriter, req *http.Request) {
+	InverseModBigHandler(resp, req, true)
+}
+
+/*
+InverseModBigHandlerZ - Just gives the big.Int solution.
+*/
+func InverseModBigHandlerZ(resp http.ResponseWriter, req *http.Request) {
+	InverseModBigHandler(resp, req, false)
+}
+
+/*
+InverseModBigHandler parses x and y as arbitrary-precision decimal or hex
+integers and writes the big.Int inverse (or its steps) to resp.  Errors,
+including the gcd != 1 case, are written as structured JSON with a 400
+status.
+*/
+func InverseModBigHandler(resp http.ResponseWriter, req *http.Request, isSteps bool) {
+	inputsX := req.URL.Query()["x"]
+	inputsY := req.URL.Query()["y"]
+
+	if len(inputsX) <= 0 || len(inputsY) <= 0 {
+		writeJSONError(resp, http.StatusBadRequest, "please provide x and y, e.g. host:port/inverse-mod-big?x=<<integer>>&y=<<integer>>")
+		return
+	}
+
+	x, ok := parseBigInt(inputsX[0])
+	if !ok {
+		writeJSONError(resp, http.StatusBadRequest, "x is not a valid decimal or hex integer")
+		return
+	}
+	y, ok := parseBigInt(inputsY[0])
+	if !ok {
+		writeJSONError(resp, http.StatusBadRequest, "y is not a valid decimal or hex integer")
+		return
+	}
+
+	if isSteps {
+		steps, _, err := InverseModBigSteps(x, y)
+		if err != nil {
+			writeJSONError(resp, http.StatusBadRequest, err.Error())
+			return
+		}
+		fmt.Fprintln(resp, steps)
+	} else {
+		z, err := InverseModBig(x, y)
+		if err != nil {
+			writeJSONError(resp, http.StatusBadRequest, err.Error())
+			return
+		}
+		fmt.Fprintln(resp, z)
+	}
+}
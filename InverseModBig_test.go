@@ -0,0 +1,29 @@
+package main
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestInverseModBig(t *testing.T) {
+	z, err := InverseModBig(big.NewInt(3), big.NewInt(7))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if z.Cmp(big.NewInt(5)) != 0 {
+		t.Fatalf("expected 5, got %s", z)
+	}
+}
+
+func TestInverseModBigNoInverse(t *testing.T) {
+	if _, err := InverseModBig(big.NewInt(3), big.NewInt(6)); err == nil {
+		t.Fatal("expected error for gcd(3, 6) = 3, got nil")
+	}
+}
+
+func TestParseBigIntHex(t *testing.T) {
+	n, ok := parseBigInt("0xff")
+	if !ok || n.Cmp(big.NewInt(255)) != 0 {
+		t.Fatalf("expected 255, got %v (ok=%v)", n, ok)
+	}
+}
@@ -0,0 +1,48 @@
+package main
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestBinaryInverseMod(t *testing.T) {
+	z, err := BinaryInverseMod(big.NewInt(3), big.NewInt(7))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if z.Cmp(big.NewInt(5)) != 0 {
+		t.Fatalf("expected 5, got %s", z)
+	}
+}
+
+func TestBinaryInverseModRejectsNonPositive(t *testing.T) {
+	if _, err := BinaryInverseMod(big.NewInt(-5), big.NewInt(7)); err == nil {
+		t.Fatal("expected error for negative x, got nil")
+	}
+	if _, err := BinaryInverseMod(big.NewInt(5), big.NewInt(-7)); err == nil {
+		t.Fatal("expected error for negative y, got nil")
+	}
+}
+
+func TestBinaryInverseModEvenGcdHasNoInverse(t *testing.T) {
+	if _, err := BinaryInverseMod(big.NewInt(2306), big.NewInt(4988)); err == nil {
+		t.Fatal("expected error for gcd(2306, 4988) = 2, got nil")
+	}
+
+	// Cross-check against math/big.GCD over a handful of even/odd pairs.
+	pairs := [][2]int64{{6, 10}, {4, 9}, {12, 18}, {15, 21}, {8, 8}}
+	for _, pair := range pairs {
+		x, y := big.NewInt(pair[0]), big.NewInt(pair[1])
+		g := new(big.Int).GCD(nil, nil, x, y)
+		z, err := BinaryInverseMod(x, y)
+		if g.Cmp(big.NewInt(1)) == 0 {
+			if err != nil {
+				t.Errorf("BinaryInverseMod(%d, %d): expected an inverse, got error %v", pair[0], pair[1], err)
+			}
+			continue
+		}
+		if err == nil {
+			t.Errorf("BinaryInverseMod(%d, %d): expected no-inverse error since gcd=%s, got z=%s", pair[0], pair[1], g, z)
+		}
+	}
+}
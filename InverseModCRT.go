@@ -0,0 +1,236 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"regexp"
+	"strconv"
+)
+
+/*
+crtStepRecord is the result of folding one more (residue, modulus)
+congruence into the running combined congruence.
+*/
+type crtStepRecord struct {
+	Inverse int64 `json:"inverse"`
+	R       int64 `json:"r"`
+	M       int64 `json:"m"`
+}
+
+/*
+crtResult is the structured form of a CRT computation: the combined
+residue r and modulus m satisfying the whole system, plus the trace of
+pairwise combinations that produced them.
+*/
+type crtResult struct {
+	R     int64           `json:"r"`
+	M     int64           `json:"m"`
+	Steps []crtStepRecord `json:"steps"`
+}
+
+/*
+crtCompute pairwise-combines (residues[i] mod moduli[i]) into a single
+congruence (r mod m) using the modular inverse, returning both a
+pedagogical text trace and the structured result.  The accumulation is
+done over big.Int internally (moduli multiply together every step, so an
+int64 accumulator would silently overflow on large-but-valid inputs) and
+only converted to int64 once the final r and m are confirmed to still fit;
+if they don't, an error is returned rather than a wrapped-around value.
+*/
+func crtCompute(residues []int64, moduli []int64) (string, crtResult, error) {
+	text := fmt.Sprintln("\n\nCombining", len(residues), "congruences via CRT...")
+
+	if len(residues) != len(moduli) {
+		return text, crtResult{}, fmt.Errorf("residues and moduli must have the same length")
+	}
+	if len(residues) == 0 {
+		return text, crtResult{}, fmt.Errorf("at least one congruence is required")
+	}
+	if moduli[0] == 0 {
+		return text, crtResult{}, fmt.Errorf("modulus cannot be zero")
+	}
+
+	m := big.NewInt(moduli[0])
+	r := new(big.Int).Mod(big.NewInt(residues[0]), m)
+
+	g := new(big.Int)
+	var steps []crtStepRecord
+	for i := 1; i < len(residues); i++ {
+		r2, m2 := big.NewInt(residues[i]), big.NewInt(moduli[i])
+		if moduli[i] == 0 {
+			return text, crtResult{}, fmt.Errorf("modulus cannot be zero")
+		}
+
+		g.GCD(nil, nil, m, m2)
+		if g.Cmp(big.NewInt(1)) != 0 {
+			return text, crtResult{}, fmt.Errorf("gcd(%s, %s) = %s, moduli must be pairwise coprime", m, m2, g)
+		}
+
+		stepText, inv, err := InverseModBigSteps(m, m2)
+		if err != nil {
+			return text, crtResult{}, err
+		}
+		text += stepText
+
+		diff := new(big.Int).Mod(new(big.Int).Sub(r2, r), m2)
+		t := new(big.Int).Mod(new(big.Int).Mul(diff, inv), m2)
+		r = new(big.Int).Mod(new(big.Int).Add(r, new(big.Int).Mul(m, t)), new(big.Int).Mul(m, m2))
+		m = new(big.Int).Mul(m, m2)
+
+		if !r.IsInt64() || !m.IsInt64() {
+			return text, crtResult{}, fmt.Errorf("combined modulus %s exceeds int64 range, use CRTBig for operands this large", m)
+		}
+
+		steps = append(steps, crtStepRecord{Inverse: inv.Int64(), R: r.Int64(), M: m.Int64()})
+		text += fmt.Sprintln("Combined with (r =", r2, ", m =", m2, "): r =", r, ", m =", m)
+	}
+
+	return text, crtResult{R: r.Int64(), M: m.Int64(), Steps: steps}, nil
+}
+
+/*
+CRT solves a system of simultaneous congruences x = residues[i] (mod
+moduli[i]) and returns (r, m) such that x = r (mod m) is equivalent to the
+whole system.  The moduli must be pairwise coprime.
+*/
+func CRT(residues []int64, moduli []int64) (int64, int64, error) {
+	_, res, err := crtCompute(residues, moduli)
+	if err != nil {
+		return 0, 0, err
+	}
+	return res.R, res.M, nil
+}
+
+/*
+CRTSteps shows the pairwise combinations, and the modular inverse used in
+each, that CRT performs to solve the system.
+*/
+func CRTSteps(residues []int64, moduli []int64) (string, error) {
+	text, _, err := crtCompute(residues, moduli)
+	return text, err
+}
+
+/*
+CRTBig solves a system of simultaneous congruences over math/big, the same
+way CRT does for int64, for residues and moduli that may exceed int64.
+*/
+func CRTBig(residues []*big.Int, moduli []*big.Int) (*big.Int, *big.Int, error) {
+	if len(residues) != len(moduli) {
+		return nil, nil, fmt.Errorf("residues and moduli must have the same length")
+	}
+	if len(residues) == 0 {
+		return nil, nil, fmt.Errorf("at least one congruence is required")
+	}
+
+	m := new(big.Int).Set(moduli[0])
+	if m.Sign() == 0 {
+		return nil, nil, fmt.Errorf("modulus cannot be zero")
+	}
+	r := new(big.Int).Mod(residues[0], m)
+
+	g := new(big.Int)
+	for i := 1; i < len(residues); i++ {
+		r2, m2 := residues[i], moduli[i]
+		if m2.Sign() == 0 {
+			return nil, nil, fmt.Errorf("modulus cannot be zero")
+		}
+
+		g.GCD(nil, nil, m, m2)
+		if g.Cmp(big.NewInt(1)) != 0 {
+			return nil, nil, fmt.Errorf("gcd(%s, %s) = %s, moduli must be pairwise coprime", m, m2, g)
+		}
+
+		inv, err := InverseModBig(m, m2)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		diff := new(big.Int).Mod(new(big.Int).Sub(r2, r), m2)
+		t := new(big.Int).Mod(new(big.Int).Mul(diff, inv), m2)
+		r = new(big.Int).Add(r, new(big.Int).Mul(m, t))
+		m = new(big.Int).Mul(m, m2)
+		r = new(big.Int).Mod(r, m)
+	}
+
+	return r, m, nil
+}
+
+/*
+CRTHandlerSteps shows the steps of combining the congruences supplied via
+repeated r= and m= query parameters.
+*/
+func CRTHandlerSteps(resp http.ResponseWriter, req *http.Request) {
+	CRTHandler(resp, req, true)
+}
+
+/*
+CRTHandlerZ just gives the combined (r, m) solution.
+*/
+func CRTHandlerZ(resp http.ResponseWriter, req *http.Request) {
+	CRTHandler(resp, req, false)
+}
+
+/*
+CRTHandler parses repeated r= and m= query parameters into parallel
+residue/modulus slices and writes the combined CRT solution, or its
+steps, to resp.
+*/
+func CRTHandler(resp http.ResponseWriter, req *http.Request, isSteps bool) {
+	rStrings := req.URL.Query()["r"]
+	mStrings := req.URL.Query()["m"]
+
+	if len(rStrings) == 0 || len(mStrings) == 0 {
+		writeError(resp, req, http.StatusBadRequest, "please provide at least one r and m, e.g. host:port/crt?r=1&r=2&m=3&m=5")
+		return
+	}
+	if len(rStrings) != len(mStrings) {
+		writeError(resp, req, http.StatusBadRequest, "the number of r params must match the number of m params")
+		return
+	}
+
+	residues := make([]int64, len(rStrings))
+	moduli := make([]int64, len(mStrings))
+	for i := range rStrings {
+		isRNumber, _ := regexp.MatchString(`^\d+$`, rStrings[i])
+		isMNumber, _ := regexp.MatchString(`^[1-9]\d*$`, mStrings[i])
+		if !isRNumber || !isMNumber {
+			writeError(resp, req, http.StatusBadRequest, "each r must be a non-negative integer and each m a positive integer")
+			return
+		}
+		r, _ := strconv.ParseInt(rStrings[i], 10, 64)
+		m, _ := strconv.ParseInt(mStrings[i], 10, 64)
+		residues[i] = r
+		moduli[i] = m
+	}
+
+	if isSteps {
+		text, res, err := crtCompute(residues, moduli)
+		if err != nil {
+			writeError(resp, req, http.StatusBadRequest, err.Error())
+			return
+		}
+		if wantsJSON(req) {
+			resp.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(resp).Encode(res)
+			return
+		}
+		resp.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		fmt.Fprintln(resp, text)
+		return
+	}
+
+	r, m, err := CRT(residues, moduli)
+	if err != nil {
+		writeError(resp, req, http.StatusBadRequest, err.Error())
+		return
+	}
+	if wantsJSON(req) {
+		resp.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(resp).Encode(crtResult{R: r, M: m})
+		return
+	}
+	resp.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	fmt.Fprintln(resp, "r =", r, ", m =", m)
+}
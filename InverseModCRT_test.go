@@ -0,0 +1,58 @@
+package main
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestCRT(t *testing.T) {
+	r, m, err := CRT([]int64{2, 3, 2}, []int64{3, 5, 7})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if r != 23 || m != 105 {
+		t.Fatalf("expected r=23, m=105, got r=%d, m=%d", r, m)
+	}
+}
+
+func TestCRTRejectsNonCoprimeModuli(t *testing.T) {
+	if _, _, err := CRT([]int64{1, 1}, []int64{4, 6}); err == nil {
+		t.Fatal("expected error for non-coprime moduli 4 and 6, got nil")
+	}
+}
+
+func TestCRTDetectsInt64Overflow(t *testing.T) {
+	// m*m2 here exceeds int64's range; CRT must error instead of returning
+	// a silently wrapped-around (r, m), matching the cross-check against
+	// CRTBig's correct result.
+	residues := []int64{5, 7}
+	moduli := []int64{3037000500, 3037000507}
+
+	if _, _, err := CRT(residues, moduli); err == nil {
+		t.Fatal("expected an overflow error, got nil")
+	}
+
+	_, mBig, err := CRTBig(
+		[]*big.Int{big.NewInt(residues[0]), big.NewInt(residues[1])},
+		[]*big.Int{big.NewInt(moduli[0]), big.NewInt(moduli[1])},
+	)
+	if err != nil {
+		t.Fatalf("CRTBig unexpected error: %v", err)
+	}
+	if mBig.IsInt64() {
+		t.Fatalf("expected the combined modulus %s to exceed int64 range", mBig)
+	}
+}
+
+func TestCRTBig(t *testing.T) {
+	residues := []*big.Int{big.NewInt(2), big.NewInt(3), big.NewInt(2)}
+	moduli := []*big.Int{big.NewInt(3), big.NewInt(5), big.NewInt(7)}
+
+	r, m, err := CRTBig(residues, moduli)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if r.Cmp(big.NewInt(23)) != 0 || m.Cmp(big.NewInt(105)) != 0 {
+		t.Fatalf("expected r=23, m=105, got r=%s, m=%s", r, m)
+	}
+}
@@ -0,0 +1,149 @@
+package main
+
+import (
+	"fmt"
+	"math/big"
+	"net/http"
+)
+
+/*
+millerRabinRounds is the number of Miller-Rabin rounds checkFermatSpecialCases
+uses to test p for primality.  It is fixed: neither InverseModFermat nor its
+handler takes a round count.
+*/
+const millerRabinRounds = 20
+
+/*
+ModPow computes base^exp mod m using right-to-left square-and-multiply.
+*/
+func ModPow(base *big.Int, exp *big.Int, mod *big.Int) *big.Int {
+	result := new(big.Int).Mod(big.NewInt(1), mod)
+	b := new(big.Int).Mod(base, mod)
+	e := new(big.Int).Set(exp)
+	zero := big.NewInt(0)
+
+	for e.Cmp(zero) > 0 {
+		if e.Bit(0) == 1 {
+			result.Mod(result.Mul(result, b), mod)
+		}
+		b.Mod(b.Mul(b, b), mod)
+		e.Rsh(e, 1)
+	}
+
+	return result
+}
+
+/*
+checkFermatSpecialCases is Fermat mode's analogue of checkSpecialCases: the
+same "fail fast with a descriptive message" shape, but over *big.Int since
+Fermat's little theorem requires an arbitrary-precision prime modulus,
+which the int64-only checkSpecialCases has no way to express.  Case 2 is
+the primality check Fermat mode requires: p must be prime for x^(p-2) mod p
+to be the inverse at all.
+*/
+func checkFermatSpecialCases(x *big.Int, p *big.Int) (bool, string) {
+	isSpecialCase := false
+	message := ""
+
+	// Case 1:  x cannot be zero
+	if x.Sign() == 0 {
+		isSpecialCase = true
+		message += "Error:  x cannot be zero."
+	}
+	if isSpecialCase {
+		return isSpecialCase, message
+	}
+
+	// Case 2:  p must be prime for Fermat's little theorem to apply
+	if !p.ProbablyPrime(millerRabinRounds) {
+		isSpecialCase = true
+		message += fmt.Sprintln(p, "is not prime, Fermat's little theorem does not apply")
+	}
+
+	return isSpecialCase, message
+}
+
+/*
+InverseModFermat computes x^(p-2) mod p, which is the modular inverse of x
+mod p whenever p is prime, per Fermat's little theorem.  p's primality is
+verified with a Miller-Rabin test; a composite p fails fast with a
+descriptive error rather than silently returning a wrong answer.
+*/
+func InverseModFermat(x *big.Int, p *big.Int) (*big.Int, error) {
+	if isSpecialCase, message := checkFermatSpecialCases(x, p); isSpecialCase {
+		return nil, fmt.Errorf("%s", message)
+	}
+
+	xModP := new(big.Int).Mod(x, p)
+	if xModP.Sign() == 0 {
+		return nil, fmt.Errorf("%s is a multiple of %s, no inverse exists", x, p)
+	}
+
+	exp := new(big.Int).Sub(p, big.NewInt(2))
+	return ModPow(xModP, exp, p), nil
+}
+
+/*
+ModPowHandler parses base, exp and mod query parameters and writes
+base^exp mod m to resp.
+*/
+func ModPowHandler(resp http.ResponseWriter, req *http.Request) {
+	baseStrings := req.URL.Query()["base"]
+	expStrings := req.URL.Query()["exp"]
+	modStrings := req.URL.Query()["mod"]
+
+	if len(baseStrings) == 0 || len(expStrings) == 0 || len(modStrings) == 0 {
+		writeJSONError(resp, http.StatusBadRequest, "please provide base, exp and mod, e.g. host:port/mod-pow?base=<<integer>>&exp=<<integer>>&mod=<<integer>>")
+		return
+	}
+
+	base, ok := parseBigInt(baseStrings[0])
+	if !ok {
+		writeJSONError(resp, http.StatusBadRequest, "base is not a valid decimal or hex integer")
+		return
+	}
+	exp, ok := parseBigInt(expStrings[0])
+	if !ok || exp.Sign() < 0 {
+		writeJSONError(resp, http.StatusBadRequest, "exp is not a valid non-negative decimal or hex integer")
+		return
+	}
+	mod, ok := parseBigInt(modStrings[0])
+	if !ok || mod.Sign() <= 0 {
+		writeJSONError(resp, http.StatusBadRequest, "mod is not a valid positive decimal or hex integer")
+		return
+	}
+
+	fmt.Fprintln(resp, ModPow(base, exp, mod))
+}
+
+/*
+InverseModFermatHandler parses x and p query parameters and writes
+InverseModFermat's result to resp.
+*/
+func InverseModFermatHandler(resp http.ResponseWriter, req *http.Request) {
+	inputsX := req.URL.Query()["x"]
+	inputsP := req.URL.Query()["p"]
+
+	if len(inputsX) == 0 || len(inputsP) == 0 {
+		writeJSONError(resp, http.StatusBadRequest, "please provide x and p, e.g. host:port/inverse-mod-fermat?x=<<integer>>&p=<<prime>>")
+		return
+	}
+
+	x, ok := parseBigInt(inputsX[0])
+	if !ok {
+		writeJSONError(resp, http.StatusBadRequest, "x is not a valid decimal or hex integer")
+		return
+	}
+	p, ok := parseBigInt(inputsP[0])
+	if !ok {
+		writeJSONError(resp, http.StatusBadRequest, "p is not a valid decimal or hex integer")
+		return
+	}
+
+	z, err := InverseModFermat(x, p)
+	if err != nil {
+		writeJSONError(resp, http.StatusBadRequest, err.Error())
+		return
+	}
+	fmt.Fprintln(resp, z)
+}
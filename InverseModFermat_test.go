@@ -0,0 +1,29 @@
+package main
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestModPow(t *testing.T) {
+	got := ModPow(big.NewInt(4), big.NewInt(13), big.NewInt(497))
+	if got.Cmp(big.NewInt(445)) != 0 {
+		t.Fatalf("expected 445, got %s", got)
+	}
+}
+
+func TestInverseModFermat(t *testing.T) {
+	z, err := InverseModFermat(big.NewInt(3), big.NewInt(7))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if z.Cmp(big.NewInt(5)) != 0 {
+		t.Fatalf("expected 5, got %s", z)
+	}
+}
+
+func TestInverseModFermatRejectsCompositeModulus(t *testing.T) {
+	if _, err := InverseModFermat(big.NewInt(3), big.NewInt(8)); err == nil {
+		t.Fatal("expected error for composite modulus 8, got nil")
+	}
+}
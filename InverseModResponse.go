@@ -0,0 +1,94 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+/*
+stepRecord is one row of the Extended Euclidean trace: the quotient q, the
+remainder r, and the running Bezout coefficient s produced at step n.
+*/
+type stepRecord struct {
+	N int   `json:"n"`
+	Q int64 `json:"q"`
+	R int64 `json:"r"`
+	S int64 `json:"s"`
+}
+
+/*
+explanationResponse is the structured JSON form of the Inverse Mod
+explanation text.
+*/
+type explanationResponse struct {
+	Explanation string `json:"explanation"`
+}
+
+/*
+validationRecord reports the validation check (z * x) mod y == 1.
+*/
+type validationRecord struct {
+	Product int64 `json:"product"`
+	Holds   bool  `json:"holds"`
+}
+
+/*
+inverseModResult is the structured form of an InverseMod computation, used
+to render JSON responses alongside the pedagogical text form.
+*/
+type inverseModResult struct {
+	X          int64            `json:"x"`
+	Y          int64            `json:"y"`
+	Z          int64            `json:"z"`
+	Gcd        int64            `json:"gcd"`
+	Exists     bool             `json:"exists"`
+	Steps      []stepRecord     `json:"steps"`
+	Validation validationRecord `json:"validation"`
+}
+
+/*
+wantsJSON inspects the ?format= query param, falling back to the Accept
+header, to decide whether a response should be rendered as JSON.
+*/
+func wantsJSON(req *http.Request) bool {
+	if format := req.URL.Query().Get("format"); format != "" {
+		return strings.EqualFold(format, "json")
+	}
+	return strings.Contains(req.Header.Get("Accept"), "application/json")
+}
+
+/*
+writeError renders an error to resp as JSON or plain text depending on
+wantsJSON, always setting the status code before writing the body.
+*/
+func writeError(resp http.ResponseWriter, req *http.Request, status int, message string) {
+	if wantsJSON(req) {
+		resp.Header().Set("Content-Type", "application/json")
+		resp.WriteHeader(status)
+		json.NewEncoder(resp).Encode(errorResponse{Error: message})
+		return
+	}
+	resp.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	resp.WriteHeader(status)
+	fmt.Fprintln(resp, message)
+}
+
+/*
+writeInverseModResult renders an inverseModResult to resp as JSON or, for
+text, the given pedagogical trace (when isSteps) or just the inverse z.
+*/
+func writeInverseModResult(resp http.ResponseWriter, req *http.Request, text string, res inverseModResult, isSteps bool) {
+	if wantsJSON(req) {
+		resp.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(resp).Encode(res)
+		return
+	}
+	resp.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	if isSteps {
+		fmt.Fprintln(resp, text)
+	} else {
+		fmt.Fprintln(resp, res.Z)
+	}
+}
@@ -0,0 +1,128 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestWantsJSON(t *testing.T) {
+	queryReq := httptest.NewRequest(http.MethodGet, "/inverse-mod?x=3&y=7&format=json", nil)
+	if !wantsJSON(queryReq) {
+		t.Fatal("expected ?format=json to request JSON")
+	}
+
+	acceptReq := httptest.NewRequest(http.MethodGet, "/inverse-mod?x=3&y=7", nil)
+	acceptReq.Header.Set("Accept", "application/json")
+	if !wantsJSON(acceptReq) {
+		t.Fatal("expected Accept: application/json to request JSON")
+	}
+
+	textReq := httptest.NewRequest(http.MethodGet, "/inverse-mod?x=3&y=7", nil)
+	if wantsJSON(textReq) {
+		t.Fatal("expected a plain request to not ask for JSON")
+	}
+}
+
+func TestInverseModHandlerJSONShape(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/inverse-mod?x=3&y=7&format=json", nil)
+	rec := httptest.NewRecorder()
+
+	InverseModHandlerSteps(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d", rec.Code)
+	}
+	if ct := rec.Header().Get("Content-Type"); ct != "application/json" {
+		t.Fatalf("expected application/json content type, got %q", ct)
+	}
+
+	var res inverseModResult
+	if err := json.Unmarshal(rec.Body.Bytes(), &res); err != nil {
+		t.Fatalf("failed to decode JSON body: %v", err)
+	}
+	if res.X != 3 || res.Y != 7 || res.Z != 5 || !res.Exists || res.Gcd != 1 {
+		t.Fatalf("unexpected result: %+v", res)
+	}
+	if len(res.Steps) == 0 {
+		t.Fatal("expected a non-empty Steps trace")
+	}
+	if !res.Validation.Holds || res.Validation.Product != 1 {
+		t.Fatalf("expected validation to hold with product 1, got %+v", res.Validation)
+	}
+}
+
+func TestInverseModHandlerBadInputSetsStatusBeforeBody(t *testing.T) {
+	for _, format := range []string{"json", "text"} {
+		req := httptest.NewRequest(http.MethodGet, "/inverse-mod?x=0&y=7&format="+format, nil)
+		rec := httptest.NewRecorder()
+
+		InverseModHandlerSteps(rec, req)
+
+		if rec.Code != http.StatusBadRequest {
+			t.Fatalf("format=%s: expected 400, got %d", format, rec.Code)
+		}
+		if rec.Body.Len() == 0 {
+			t.Fatalf("format=%s: expected a non-empty error body", format)
+		}
+	}
+}
+
+func TestInverseModHandlerBadInputJSONShape(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/inverse-mod?x=0&y=7&format=json", nil)
+	rec := httptest.NewRecorder()
+
+	InverseModHandlerSteps(rec, req)
+
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("expected 400, got %d", rec.Code)
+	}
+
+	var errRes errorResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &errRes); err != nil {
+		t.Fatalf("failed to decode JSON error body: %v", err)
+	}
+	if errRes.Error == "" {
+		t.Fatal("expected a non-empty error message")
+	}
+}
+
+func TestInverseModHandlerZJSON(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/inverse-mod-z?x=3&y=7", nil)
+	req.Header.Set("Accept", "application/json")
+	rec := httptest.NewRecorder()
+
+	InverseModHandlerZ(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d", rec.Code)
+	}
+
+	var res inverseModResult
+	if err := json.Unmarshal(rec.Body.Bytes(), &res); err != nil {
+		t.Fatalf("failed to decode JSON body: %v", err)
+	}
+	if res.Z != 5 {
+		t.Fatalf("expected z=5, got %d", res.Z)
+	}
+}
+
+func TestInverseModHandlerExplanationJSON(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/inverse-mod-explanation?format=json", nil)
+	rec := httptest.NewRecorder()
+
+	InverseModHandlerExplanation(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d", rec.Code)
+	}
+
+	var res explanationResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &res); err != nil {
+		t.Fatalf("failed to decode JSON body: %v", err)
+	}
+	if res.Explanation == "" {
+		t.Fatal("expected a non-empty explanation")
+	}
+}
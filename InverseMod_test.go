@@ -0,0 +1,31 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestInverseMod(t *testing.T) {
+	if got := InverseMod(3, 7); got != 5 {
+		t.Fatalf("expected 5, got %d", got)
+	}
+	if got := InverseMod(4, 9); got != 7 {
+		t.Fatalf("expected 7, got %d", got)
+	}
+}
+
+func TestInverseModNoInverseReportsGcdAndSteps(t *testing.T) {
+	text, res := inverseModCompute(3, 6)
+	if res.Exists {
+		t.Fatal("expected Exists=false for gcd(3, 6) = 3")
+	}
+	if res.Gcd != 3 {
+		t.Fatalf("expected Gcd=3, got %d", res.Gcd)
+	}
+	if len(res.Steps) == 0 {
+		t.Fatal("expected the Euclidean trace to still populate Steps for a no-inverse input")
+	}
+	if !strings.Contains(text, "Step 1") {
+		t.Fatalf("expected the text trace to include step lines, got: %s", text)
+	}
+}